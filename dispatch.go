@@ -0,0 +1,24 @@
+package hyprland
+
+import (
+	"context"
+
+	"github.com/thiagokokada/hyprland-go/dispatch"
+)
+
+// DispatchTyped is like [IPCClient.Dispatch], but takes typed commands built
+// from the dispatch subpackage instead of hand-formatted strings.
+// Accept multiple commands at the same time, in this case it will use batch
+// mode, similar to 'hyprctl dispatch --batch'.
+func (c *IPCClient) DispatchTyped(cmds ...dispatch.Dispatchable) error {
+	return c.DispatchTypedCtx(context.Background(), cmds...)
+}
+
+// Same as [IPCClient.DispatchTyped], but bound to ctx.
+func (c *IPCClient) DispatchTypedCtx(ctx context.Context, cmds ...dispatch.Dispatchable) error {
+	params := make([]string, len(cmds))
+	for i, cmd := range cmds {
+		params[i] = cmd.Render()
+	}
+	return c.DispatchCtx(ctx, params...)
+}
@@ -0,0 +1,127 @@
+// Package dispatch provides typed builders for Hyprland's dispatch
+// commands, so callers don't have to hand-format strings like
+// "movetoworkspace 3,address:0x...".
+package dispatch
+
+import "fmt"
+
+// Dispatchable is any dispatcher command that knows how to render itself to
+// the wire string expected by `hyprctl dispatch`.
+type Dispatchable interface {
+	Render() string
+}
+
+// Direction is a four-way direction, e.g. for [MoveFocus].
+type Direction string
+
+const (
+	DirectionUp    Direction = "u"
+	DirectionDown  Direction = "d"
+	DirectionLeft  Direction = "l"
+	DirectionRight Direction = "r"
+)
+
+// WorkspaceSelector selects a workspace, following Hyprland's selector
+// grammar. Build one with [WorkspaceID], [WorkspaceName], [WorkspaceSpecial]
+// or [WorkspaceRelative] rather than constructing it directly.
+type WorkspaceSelector struct {
+	selector string
+}
+
+// WorkspaceID selects a workspace by its numeric id.
+func WorkspaceID(id int) WorkspaceSelector {
+	return WorkspaceSelector{fmt.Sprintf("%d", id)}
+}
+
+// WorkspaceName selects a workspace by its name.
+func WorkspaceName(name string) WorkspaceSelector {
+	return WorkspaceSelector{"name:" + name}
+}
+
+// WorkspaceSpecial selects a special (scratchpad-like) workspace by name.
+func WorkspaceSpecial(name string) WorkspaceSelector {
+	return WorkspaceSelector{"special:" + name}
+}
+
+// WorkspaceRelative selects a workspace relative to the active one, e.g.
+// WorkspaceRelative(1) is the next workspace and WorkspaceRelative(-1) is
+// the previous one.
+func WorkspaceRelative(delta int) WorkspaceSelector {
+	if delta >= 0 {
+		return WorkspaceSelector{fmt.Sprintf("+%d", delta)}
+	}
+	return WorkspaceSelector{fmt.Sprintf("%d", delta)}
+}
+
+func (s WorkspaceSelector) String() string { return s.selector }
+
+// WindowSelector selects a window, following Hyprland's selector grammar.
+// Build one with [WindowAddress], [WindowPid] or [WindowClass] rather than
+// constructing it directly.
+type WindowSelector struct {
+	selector string
+}
+
+// WindowAddress selects a window by its address, e.g. "0x1234abcd".
+func WindowAddress(address string) WindowSelector {
+	return WindowSelector{"address:" + address}
+}
+
+// WindowPid selects a window by the pid of its owning process.
+func WindowPid(pid int) WindowSelector {
+	return WindowSelector{fmt.Sprintf("pid:%d", pid)}
+}
+
+// WindowClass selects a window whose class matches regex, e.g.
+// WindowClass("firefox").
+func WindowClass(regex string) WindowSelector {
+	return WindowSelector{fmt.Sprintf("class:^%s$", regex)}
+}
+
+func (s WindowSelector) String() string { return s.selector }
+
+type execCmd struct{ cmd string }
+
+// Exec runs cmd, similar to 'hyprctl dispatch exec'.
+func Exec(cmd string) Dispatchable { return execCmd{cmd} }
+
+func (e execCmd) Render() string { return fmt.Sprintf("exec %s", e.cmd) }
+
+type moveToWorkspaceCmd struct{ target WorkspaceSelector }
+
+// MoveToWorkspace moves the active window to target.
+func MoveToWorkspace(target WorkspaceSelector) Dispatchable {
+	return moveToWorkspaceCmd{target}
+}
+
+func (m moveToWorkspaceCmd) Render() string {
+	return fmt.Sprintf("movetoworkspace %s", m.target)
+}
+
+type focusWindowCmd struct{ sel WindowSelector }
+
+// FocusWindow focuses the window matching sel.
+func FocusWindow(sel WindowSelector) Dispatchable { return focusWindowCmd{sel} }
+
+func (f focusWindowCmd) Render() string { return fmt.Sprintf("focuswindow %s", f.sel) }
+
+type toggleFloatingCmd struct{ sel WindowSelector }
+
+// ToggleFloating toggles the floating state of the window matching sel.
+func ToggleFloating(sel WindowSelector) Dispatchable { return toggleFloatingCmd{sel} }
+
+func (t toggleFloatingCmd) Render() string { return fmt.Sprintf("togglefloating %s", t.sel) }
+
+type workspaceCmd struct{ target WorkspaceSelector }
+
+// Workspace switches the active workspace to target.
+func Workspace(target WorkspaceSelector) Dispatchable { return workspaceCmd{target} }
+
+func (w workspaceCmd) Render() string { return fmt.Sprintf("workspace %s", w.target) }
+
+type moveFocusCmd struct{ dir Direction }
+
+// MoveFocus moves the focus in dir.
+func MoveFocus(dir Direction) Dispatchable { return moveFocusCmd{dir} }
+
+func (m moveFocusCmd) Render() string { return fmt.Sprintf("movefocus %s", m.dir) }
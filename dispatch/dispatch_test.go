@@ -0,0 +1,32 @@
+package dispatch
+
+import "testing"
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  Dispatchable
+		want string
+	}{
+		{"exec", Exec("kitty"), "exec kitty"},
+		{"move to workspace id", MoveToWorkspace(WorkspaceID(3)), "movetoworkspace 3"},
+		{"move to workspace name", MoveToWorkspace(WorkspaceName("web")), "movetoworkspace name:web"},
+		{"move to workspace special", MoveToWorkspace(WorkspaceSpecial("scratch")), "movetoworkspace special:scratch"},
+		{"move to workspace relative forward", MoveToWorkspace(WorkspaceRelative(1)), "movetoworkspace +1"},
+		{"move to workspace relative back", MoveToWorkspace(WorkspaceRelative(-1)), "movetoworkspace -1"},
+		{"focus window address", FocusWindow(WindowAddress("0x1234abcd")), "focuswindow address:0x1234abcd"},
+		{"focus window pid", FocusWindow(WindowPid(42)), "focuswindow pid:42"},
+		{"focus window class", FocusWindow(WindowClass("firefox")), "focuswindow class:^firefox$"},
+		{"toggle floating", ToggleFloating(WindowAddress("0x1")), "togglefloating address:0x1"},
+		{"workspace", Workspace(WorkspaceID(2)), "workspace 2"},
+		{"move focus", MoveFocus(DirectionLeft), "movefocus l"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cmd.Render(); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package hyprland
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Initiate a new event client or panic.
+// This should be the preferred method for user scripts, since it will
+// automatically find the proper socket to connect and use the
+// HYPRLAND_INSTANCE_SIGNATURE for the current user.
+// If you need to connect to arbitrary user instances or need a method that
+// will not panic on error, use [NewEventClient] instead.
+func MustEventClient() *EventClient {
+	_, eventSocket := socketPaths()
+	return must1(NewEventClient(eventSocket))
+}
+
+// Initiate a new event client.
+// Receives as parameter an eventSocket that is generally localised in
+// '$XDG_RUNTIME_DIR/hypr/$HYPRLAND_INSTANCE_SIGNATURE/.socket2.sock'.
+func NewEventClient(eventSocket string) (*EventClient, error) {
+	if eventSocket == "" {
+		return nil, errors.New("empty event socket")
+	}
+
+	conn, err := net.Dial("unix", eventSocket)
+	if err != nil {
+		return nil, fmt.Errorf("error while connecting to socket: %w", err)
+	}
+
+	return &EventClient{conn: conn}, nil
+}
+
+// Subscribe reads events from the event socket and dispatches each one to
+// handler, blocking until ctx is cancelled or the connection is closed.
+// handler is usually a struct embedding [BaseEventHandler], so only the
+// events of interest need to be implemented.
+func (c *EventClient) Subscribe(ctx context.Context, handler EventHandler) error {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			c.conn.Close()
+		case <-done:
+		}
+	}()
+
+	scanner := bufio.NewScanner(c.conn)
+	for scanner.Scan() {
+		dispatchEvent(scanner.Text(), handler)
+	}
+	if err := scanner.Err(); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("error while reading event: %w", err)
+	}
+	return ctx.Err()
+}
+
+// Split data on "," into exactly n fields, keeping any extra commas (e.g.
+// from a window title) in the last field.
+func splitFields(data string, n int) []string {
+	fields := strings.SplitN(data, ",", n)
+	for len(fields) < n {
+		fields = append(fields, "")
+	}
+	return fields
+}
+
+func dispatchEvent(line string, handler EventHandler) {
+	event, data, ok := strings.Cut(line, ">>")
+	if !ok {
+		return
+	}
+
+	switch EventType(event) {
+	case EventWorkspace:
+		handler.Workspace(WorkspaceEvent{Name: data})
+	case EventFocusedMon:
+		f := splitFields(data, 2)
+		handler.FocusedMon(FocusedMonEvent{MonitorName: f[0], WorkspaceName: f[1]})
+	case EventActiveWindow:
+		f := splitFields(data, 2)
+		handler.ActiveWindow(ActiveWindowEvent{Class: f[0], Title: f[1]})
+	case EventOpenWindow:
+		f := splitFields(data, 4)
+		handler.OpenWindow(OpenWindowEvent{Address: f[0], Workspace: f[1], Class: f[2], Title: f[3]})
+	case EventCloseWindow:
+		handler.CloseWindow(CloseWindowEvent{Address: data})
+	case EventMoveWindow:
+		f := splitFields(data, 2)
+		handler.MoveWindow(MoveWindowEvent{Address: f[0], Workspace: f[1]})
+	case EventFullscreen:
+		handler.Fullscreen(FullscreenEvent{Enabled: data == "1"})
+	case EventMonitorAdded:
+		handler.MonitorAdded(MonitorAddedEvent{Name: data})
+	case EventMonitorRemoved:
+		handler.MonitorRemoved(MonitorRemovedEvent{Name: data})
+	case EventCreateWorkspace:
+		handler.CreateWorkspace(CreateWorkspaceEvent{Name: data})
+	case EventDestroyWorkspace:
+		handler.DestroyWorkspace(DestroyWorkspaceEvent{Name: data})
+	case EventSubmap:
+		handler.Submap(SubmapEvent{Name: data})
+	default:
+		handler.OnRaw(ReceivedData{Type: EventType(event), Data: RawData(data)})
+	}
+}
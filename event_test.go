@@ -0,0 +1,104 @@
+package hyprland
+
+import "testing"
+
+// recordingHandler records which typed method dispatchEvent called, and
+// with what value, so tests can assert on both without a real event
+// socket.
+type recordingHandler struct {
+	BaseEventHandler
+	calls []string
+	last  any
+}
+
+func (h *recordingHandler) record(name string, v any) {
+	h.calls = append(h.calls, name)
+	h.last = v
+}
+
+func (h *recordingHandler) Workspace(e WorkspaceEvent)               { h.record("Workspace", e) }
+func (h *recordingHandler) FocusedMon(e FocusedMonEvent)             { h.record("FocusedMon", e) }
+func (h *recordingHandler) ActiveWindow(e ActiveWindowEvent)         { h.record("ActiveWindow", e) }
+func (h *recordingHandler) OpenWindow(e OpenWindowEvent)             { h.record("OpenWindow", e) }
+func (h *recordingHandler) CloseWindow(e CloseWindowEvent)           { h.record("CloseWindow", e) }
+func (h *recordingHandler) MoveWindow(e MoveWindowEvent)             { h.record("MoveWindow", e) }
+func (h *recordingHandler) Fullscreen(e FullscreenEvent)             { h.record("Fullscreen", e) }
+func (h *recordingHandler) MonitorAdded(e MonitorAddedEvent)         { h.record("MonitorAdded", e) }
+func (h *recordingHandler) MonitorRemoved(e MonitorRemovedEvent)     { h.record("MonitorRemoved", e) }
+func (h *recordingHandler) CreateWorkspace(e CreateWorkspaceEvent)   { h.record("CreateWorkspace", e) }
+func (h *recordingHandler) DestroyWorkspace(e DestroyWorkspaceEvent) { h.record("DestroyWorkspace", e) }
+func (h *recordingHandler) Submap(e SubmapEvent)                     { h.record("Submap", e) }
+func (h *recordingHandler) OnRaw(d ReceivedData)                     { h.record("OnRaw", d) }
+
+func TestDispatchEvent(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+		val  any
+	}{
+		{"workspace", "workspace>>2", "Workspace", WorkspaceEvent{Name: "2"}},
+		{"focusedmon", "focusedmon>>DP-1,2", "FocusedMon", FocusedMonEvent{MonitorName: "DP-1", WorkspaceName: "2"}},
+		{
+			"activewindow",
+			"activewindow>>firefox,My Title, with a comma",
+			"ActiveWindow",
+			ActiveWindowEvent{Class: "firefox", Title: "My Title, with a comma"},
+		},
+		{
+			"openwindow",
+			"openwindow>>0x1,2,firefox,My Title, with a comma",
+			"OpenWindow",
+			OpenWindowEvent{Address: "0x1", Workspace: "2", Class: "firefox", Title: "My Title, with a comma"},
+		},
+		{"closewindow", "closewindow>>0x1", "CloseWindow", CloseWindowEvent{Address: "0x1"}},
+		{"movewindow", "movewindow>>0x1,2", "MoveWindow", MoveWindowEvent{Address: "0x1", Workspace: "2"}},
+		{"fullscreen", "fullscreen>>1", "Fullscreen", FullscreenEvent{Enabled: true}},
+		{"monitoradded", "monitoradded>>DP-1", "MonitorAdded", MonitorAddedEvent{Name: "DP-1"}},
+		{"monitorremoved", "monitorremoved>>DP-1", "MonitorRemoved", MonitorRemovedEvent{Name: "DP-1"}},
+		{"createworkspace", "createworkspace>>5", "CreateWorkspace", CreateWorkspaceEvent{Name: "5"}},
+		{"destroyworkspace", "destroyworkspace>>5", "DestroyWorkspace", DestroyWorkspaceEvent{Name: "5"}},
+		{"submap", "submap>>resize", "Submap", SubmapEvent{Name: "resize"}},
+		{
+			"unknown event falls through to OnRaw",
+			"somenewevent>>foo,bar",
+			"OnRaw",
+			ReceivedData{Type: EventType("somenewevent"), Data: RawData("foo,bar")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &recordingHandler{}
+			dispatchEvent(tt.line, h)
+
+			if len(h.calls) != 1 || h.calls[0] != tt.want {
+				t.Fatalf("got calls %v, want [%s]", h.calls, tt.want)
+			}
+			if h.last != tt.val {
+				t.Fatalf("got %#v, want %#v", h.last, tt.val)
+			}
+		})
+	}
+}
+
+func TestSplitFields(t *testing.T) {
+	got := splitFields("a,b,c,d,e", 4)
+	want := []string{"a", "b", "c", "d,e"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	got = splitFields("a", 3)
+	want = []string{"a", "", ""}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
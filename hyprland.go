@@ -2,6 +2,7 @@ package hyprland
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"os/user"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 const (
@@ -23,6 +25,7 @@ const (
 // validations, at the expense of not reporting some errors in the IPC.
 type IPCClient struct {
 	Validate    bool
+	Pool        *Pool
 	requestConn *net.UnixAddr
 	eventConn   net.Conn
 }
@@ -116,10 +119,10 @@ func unmarshalResponse(response []byte, v any) (err error) {
 	return nil
 }
 
-func (c *IPCClient) doRequest(command string, params ...string) (response []byte, err error) {
+func (c *IPCClient) doRequestCtx(ctx context.Context, command string, params ...string) (response []byte, err error) {
 	requests := prepareRequests(command, params)
 	for _, req := range requests {
-		resp, err := c.Request(req)
+		resp, err := c.RequestCtx(ctx, req)
 		if err != nil {
 			return nil, fmt.Errorf("error while doing request: %w", err)
 		}
@@ -128,31 +131,38 @@ func (c *IPCClient) doRequest(command string, params ...string) (response []byte
 	return response, nil
 }
 
-// Initiate a new client or panic.
-// This should be the preferred method for user scripts, since it will
-// automatically find the proper socket to connect and use the
-// HYPRLAND_INSTANCE_SIGNATURE for the current user.
-// If you need to connect to arbitrary user instances or need a method that
-// will not panic on error, use [NewClient] instead.
-func MustClient() *IPCClient {
+func (c *IPCClient) doRequest(command string, params ...string) (response []byte, err error) {
+	return c.doRequestCtx(context.Background(), command, params...)
+}
+
+// Find the request and event sockets for the current user, following the
+// same lookup Hyprland itself uses.
+// https://github.com/hyprwm/Hyprland/blob/83a5395eaa99fecef777827fff1de486c06b6180/hyprctl/main.cpp#L53-L62
+func socketPaths() (requestSocket, eventSocket string) {
 	his := os.Getenv("HYPRLAND_INSTANCE_SIGNATURE")
 	if his == "" {
 		panic("HYPRLAND_INSTANCE_SIGNATURE is empty, are you using Hyprland?")
 	}
 
-	// https://github.com/hyprwm/Hyprland/blob/83a5395eaa99fecef777827fff1de486c06b6180/hyprctl/main.cpp#L53-L62
 	runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
 	if runtimeDir == "" {
 		user := must1(user.Current()).Uid
 		runtimeDir = filepath.Join("/run/user", user)
 	}
 
-	return must1(
-		NewClient(
-			filepath.Join(runtimeDir, "hypr", his, ".socket.sock"),
-			filepath.Join(runtimeDir, "hypr", his, ".socket2.sock"),
-		),
-	)
+	return filepath.Join(runtimeDir, "hypr", his, ".socket.sock"),
+		filepath.Join(runtimeDir, "hypr", his, ".socket2.sock")
+}
+
+// Initiate a new client or panic.
+// This should be the preferred method for user scripts, since it will
+// automatically find the proper socket to connect and use the
+// HYPRLAND_INSTANCE_SIGNATURE for the current user.
+// If you need to connect to arbitrary user instances or need a method that
+// will not panic on error, use [NewClient] instead.
+func MustClient() *IPCClient {
+	requestSocket, eventSocket := socketPaths()
+	return must1(NewClient(requestSocket, eventSocket))
 }
 
 // Initiate a new client.
@@ -187,25 +197,49 @@ func NewClient(requestSocket, eventSocket string) (*IPCClient, error) {
 // Keep in mind that there is no validation. In case of an invalid request, the
 // response will generally be something different from "ok".
 func (c *IPCClient) Request(request []byte) (response []byte, err error) {
+	return c.RequestCtx(context.Background(), request)
+}
+
+// Same as [IPCClient.Request], but the request is aborted and ctx.Err() is
+// returned as soon as ctx is done, instead of blocking forever on a hung
+// Hyprland.
+func (c *IPCClient) RequestCtx(ctx context.Context, request []byte) (response []byte, err error) {
 	if len(request) == 0 {
 		return nil, errors.New("empty request")
 	}
 
-	// Connect to the request socket
-	conn, err := net.DialUnix("unix", nil, c.requestConn)
-	defer conn.Close()
+	// Connect to the request socket, reusing one from the pool if the
+	// client was built with [NewClientWithPool].
+	var conn *net.UnixConn
+	if c.Pool != nil {
+		conn, err = c.Pool.get()
+	} else {
+		conn, err = net.DialUnix("unix", nil, c.requestConn)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error while connecting to socket: %w", err)
 	}
 
+	// Wake up the socket as soon as ctx is done, turning a blocked
+	// Write/Read into an immediate error, without leaking a timer if the
+	// request finishes first.
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Unix(0, 0)) })
+
 	// Send the request to the socket
 	request = append([]byte{'j', '/'}, request...)
 	_, err = conn.Write(request)
 	if err != nil {
+		stop()
+		conn.Close()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		return nil, fmt.Errorf("error while writing to socket: %w", err)
 	}
 
-	// Get the response back
+	// Get the response back. A short read is always the end-of-message
+	// marker, whether the connection is fresh or pooled, since Hyprland
+	// answers a single request with a single message.
 	var resp bytes.Buffer
 	buf := make([]byte, BUF_SIZE)
 	for {
@@ -214,6 +248,11 @@ func (c *IPCClient) Request(request []byte) (response []byte, err error) {
 			if err == io.EOF {
 				break
 			}
+			stop()
+			conn.Close()
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
 			return nil, err
 		}
 
@@ -223,13 +262,31 @@ func (c *IPCClient) Request(request []byte) (response []byte, err error) {
 		}
 	}
 
+	// Stop the AfterFunc before the connection becomes visible to another
+	// goroutine (by going back into the pool). Otherwise a ctx deadline
+	// firing in this exact window could call SetDeadline on a connection
+	// a different, unrelated request has already checked out.
+	stop()
+
+	if c.Pool != nil && ctx.Err() == nil {
+		conn.SetDeadline(time.Time{})
+		c.Pool.put(conn)
+	} else {
+		conn.Close()
+	}
+
 	return resp.Bytes(), nil
 }
 
 // Get option command, similar to 'hyprctl activewindow'.
 // Returns a [Window] object.
 func (c *IPCClient) ActiveWindow() (w Window, err error) {
-	response, err := c.doRequest("activewindow")
+	return c.ActiveWindowCtx(context.Background())
+}
+
+// Same as [IPCClient.ActiveWindow], but bound to ctx.
+func (c *IPCClient) ActiveWindowCtx(ctx context.Context) (w Window, err error) {
+	response, err := c.doRequestCtx(ctx, "activewindow")
 	if err != nil {
 		return w, err
 	}
@@ -239,7 +296,12 @@ func (c *IPCClient) ActiveWindow() (w Window, err error) {
 // Get option command, similar to 'hyprctl activeworkspace'.
 // Returns a [Workspace] object.
 func (c *IPCClient) ActiveWorkspace() (w Workspace, err error) {
-	response, err := c.doRequest("activeworkspace")
+	return c.ActiveWorkspaceCtx(context.Background())
+}
+
+// Same as [IPCClient.ActiveWorkspace], but bound to ctx.
+func (c *IPCClient) ActiveWorkspaceCtx(ctx context.Context) (w Workspace, err error) {
+	response, err := c.doRequestCtx(ctx, "activeworkspace")
 	if err != nil {
 		return w, err
 	}
@@ -249,17 +311,57 @@ func (c *IPCClient) ActiveWorkspace() (w Workspace, err error) {
 // Get option command, similar to 'hyprctl clients'.
 // Returns a [Client] object.
 func (c *IPCClient) Clients() (cl []Client, err error) {
-	response, err := c.doRequest("clients")
+	return c.ClientsCtx(context.Background())
+}
+
+// Same as [IPCClient.Clients], but bound to ctx.
+func (c *IPCClient) ClientsCtx(ctx context.Context) (cl []Client, err error) {
+	response, err := c.doRequestCtx(ctx, "clients")
 	if err != nil {
 		return cl, err
 	}
 	return cl, unmarshalResponse(response, &cl)
 }
 
+// Get option command, similar to 'hyprctl monitors'.
+// Returns a [Monitor] object.
+func (c *IPCClient) Monitors() (m []Monitor, err error) {
+	return c.MonitorsCtx(context.Background())
+}
+
+// Same as [IPCClient.Monitors], but bound to ctx.
+func (c *IPCClient) MonitorsCtx(ctx context.Context) (m []Monitor, err error) {
+	response, err := c.doRequestCtx(ctx, "monitors")
+	if err != nil {
+		return m, err
+	}
+	return m, unmarshalResponse(response, &m)
+}
+
+// Get option command, similar to 'hyprctl workspaces'.
+// Returns a [Workspace] object.
+func (c *IPCClient) Workspaces() (ws []Workspace, err error) {
+	return c.WorkspacesCtx(context.Background())
+}
+
+// Same as [IPCClient.Workspaces], but bound to ctx.
+func (c *IPCClient) WorkspacesCtx(ctx context.Context) (ws []Workspace, err error) {
+	response, err := c.doRequestCtx(ctx, "workspaces")
+	if err != nil {
+		return ws, err
+	}
+	return ws, unmarshalResponse(response, &ws)
+}
+
 // Get option command, similar to 'hyprctl cursorpos'.
 // Returns a [CursorPos] object.
 func (c *IPCClient) CursorPos() (cu []CursorPos, err error) {
-	response, err := c.doRequest("cursorpos")
+	return c.CursorPosCtx(context.Background())
+}
+
+// Same as [IPCClient.CursorPos], but bound to ctx.
+func (c *IPCClient) CursorPosCtx(ctx context.Context) (cu []CursorPos, err error) {
+	response, err := c.doRequestCtx(ctx, "cursorpos")
 	if err != nil {
 		return cu, err
 	}
@@ -270,7 +372,12 @@ func (c *IPCClient) CursorPos() (cu []CursorPos, err error) {
 // Accept multiple commands at the same time, in this case it will use batch
 // mode, similar to 'hyprctl dispatch --batch'.
 func (c *IPCClient) Dispatch(params ...string) error {
-	response, err := c.doRequest("dispatch", params...)
+	return c.DispatchCtx(context.Background(), params...)
+}
+
+// Same as [IPCClient.Dispatch], but bound to ctx.
+func (c *IPCClient) DispatchCtx(ctx context.Context, params ...string) error {
+	response, err := c.doRequestCtx(ctx, "dispatch", params...)
 	if err != nil {
 		return err
 	}
@@ -280,7 +387,12 @@ func (c *IPCClient) Dispatch(params ...string) error {
 // Get option command, similar to 'hyprctl getoption'.
 // Returns an [Option] object.
 func (c *IPCClient) GetOption(name string) (o Option, err error) {
-	response, err := c.doRequest("getoption", name)
+	return c.GetOptionCtx(context.Background(), name)
+}
+
+// Same as [IPCClient.GetOption], but bound to ctx.
+func (c *IPCClient) GetOptionCtx(ctx context.Context, name string) (o Option, err error) {
+	response, err := c.doRequestCtx(ctx, "getoption", name)
 	if err != nil {
 		return o, err
 	}
@@ -290,7 +402,12 @@ func (c *IPCClient) GetOption(name string) (o Option, err error) {
 // Kill command, similar to 'hyprctl kill'.
 // Will NOT wait for the user to click in the window.
 func (c *IPCClient) Kill() error {
-	response, err := c.doRequest("kill")
+	return c.KillCtx(context.Background())
+}
+
+// Same as [IPCClient.Kill], but bound to ctx.
+func (c *IPCClient) KillCtx(ctx context.Context) error {
+	response, err := c.doRequestCtx(ctx, "kill")
 	if err != nil {
 		return err
 	}
@@ -299,7 +416,12 @@ func (c *IPCClient) Kill() error {
 
 // Reload command, similar to 'hyprctl reload'.
 func (c *IPCClient) Reload() error {
-	response, err := c.doRequest("reload")
+	return c.ReloadCtx(context.Background())
+}
+
+// Same as [IPCClient.Reload], but bound to ctx.
+func (c *IPCClient) ReloadCtx(ctx context.Context) error {
+	response, err := c.doRequestCtx(ctx, "reload")
 	if err != nil {
 		return err
 	}
@@ -309,7 +431,12 @@ func (c *IPCClient) Reload() error {
 // Get option command, similar to 'hyprctl version'.
 // Returns an [Version] object.
 func (c *IPCClient) Version() (v Version, err error) {
-	response, err := c.doRequest("version")
+	return c.VersionCtx(context.Background())
+}
+
+// Same as [IPCClient.Version], but bound to ctx.
+func (c *IPCClient) VersionCtx(ctx context.Context) (v Version, err error) {
+	response, err := c.doRequestCtx(ctx, "version")
 	if err != nil {
 		return v, err
 	}
@@ -318,7 +445,12 @@ func (c *IPCClient) Version() (v Version, err error) {
 
 // Get option command, similar to 'hyprctl splash'.
 func (c *IPCClient) Splash() (s string, err error) {
-	response, err := c.doRequest("splash")
+	return c.SplashCtx(context.Background())
+}
+
+// Same as [IPCClient.Splash], but bound to ctx.
+func (c *IPCClient) SplashCtx(ctx context.Context) (s string, err error) {
+	response, err := c.doRequestCtx(ctx, "splash")
 	if err != nil {
 		return "", err
 	}
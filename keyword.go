@@ -0,0 +1,62 @@
+package hyprland
+
+import (
+	"context"
+	"fmt"
+)
+
+// Keyword sets a config option at runtime, similar to
+// 'hyprctl keyword <name> <value>'.
+func (c *IPCClient) Keyword(name, value string) error {
+	return c.KeywordCtx(context.Background(), name, value)
+}
+
+// Same as [IPCClient.Keyword], but bound to ctx.
+func (c *IPCClient) KeywordCtx(ctx context.Context, name, value string) error {
+	param := fmt.Sprintf("%s %s", name, value)
+	response, err := c.doRequestCtx(ctx, "keyword", param)
+	if err != nil {
+		return err
+	}
+	return c.validateResponse([]string{param}, response)
+}
+
+// Keywords applies every name/value pair in pairs, similar to calling
+// [IPCClient.Keyword] once per pair, but leveraging batch mode so a whole
+// theme is sent in as few requests as possible. Up to MAX_COMMANDS pairs go
+// in a single batch request; more pairs are split across several requests,
+// in map iteration order, and pairs sent before a failing request stay
+// applied.
+func (c *IPCClient) Keywords(pairs map[string]string) error {
+	return c.KeywordsCtx(context.Background(), pairs)
+}
+
+// Same as [IPCClient.Keywords], but bound to ctx.
+func (c *IPCClient) KeywordsCtx(ctx context.Context, pairs map[string]string) error {
+	params := make([]string, 0, len(pairs))
+	for name, value := range pairs {
+		params = append(params, fmt.Sprintf("%s %s", name, value))
+	}
+
+	response, err := c.doRequestCtx(ctx, "keyword", params...)
+	if err != nil {
+		return err
+	}
+	return c.validateResponse(params, response)
+}
+
+// Get option command, similar to 'hyprctl getoption'.
+// Unlike [IPCClient.GetOption], it returns a [TypedOption] able to
+// represent int, float, string and vector values.
+func (c *IPCClient) GetOptionTyped(name string) (o TypedOption, err error) {
+	return c.GetOptionTypedCtx(context.Background(), name)
+}
+
+// Same as [IPCClient.GetOptionTyped], but bound to ctx.
+func (c *IPCClient) GetOptionTypedCtx(ctx context.Context, name string) (o TypedOption, err error) {
+	response, err := c.doRequestCtx(ctx, "getoption", name)
+	if err != nil {
+		return o, err
+	}
+	return o, unmarshalResponse(response, &o)
+}
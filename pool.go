@@ -0,0 +1,96 @@
+package hyprland
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// Pool is a bounded set of pre-dialed connections to the request socket,
+// reused across calls instead of dialing (and tearing down) a fresh socket
+// per request. Build one through [NewClientWithPool] rather than directly.
+type Pool struct {
+	addr *net.UnixAddr
+	idle time.Duration
+	size int
+
+	mu    sync.Mutex
+	conns []*pooledConn
+}
+
+type pooledConn struct {
+	*net.UnixConn
+	lastUsed time.Time
+}
+
+func newPool(addr *net.UnixAddr, size int, idle time.Duration) *Pool {
+	return &Pool{addr: addr, idle: idle, size: size}
+}
+
+// get returns a ready-to-use connection, reusing an idle one from the pool
+// when available, otherwise dialing a new one.
+func (p *Pool) get() (*net.UnixConn, error) {
+	p.mu.Lock()
+	for len(p.conns) > 0 {
+		pc := p.conns[len(p.conns)-1]
+		p.conns = p.conns[:len(p.conns)-1]
+		if p.idle > 0 && time.Since(pc.lastUsed) > p.idle {
+			pc.Close()
+			continue
+		}
+		p.mu.Unlock()
+		return pc.UnixConn, nil
+	}
+	p.mu.Unlock()
+
+	conn, err := net.DialUnix("unix", nil, p.addr)
+	if err != nil {
+		return nil, fmt.Errorf("error while connecting to socket: %w", err)
+	}
+	return conn, nil
+}
+
+// put returns conn to the pool for reuse, closing it instead if the pool is
+// already at capacity.
+func (p *Pool) put(conn *net.UnixConn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.conns) >= p.size {
+		conn.Close()
+		return
+	}
+	p.conns = append(p.conns, &pooledConn{UnixConn: conn, lastUsed: time.Now()})
+}
+
+// Close closes every idle connection currently held by the pool. In-flight
+// requests using a connection checked out from the pool are unaffected.
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var err error
+	for _, pc := range p.conns {
+		if cerr := pc.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	p.conns = nil
+	return err
+}
+
+// Initiate a new client backed by a bounded pool of up to size pre-dialed
+// connections to the request socket, each reused for up to idle between
+// requests before being redialed. This avoids paying a dial-per-request
+// cost when a caller issues many requests in a row, e.g. rebuilding a bar
+// on every event. Use idle <= 0 to keep pooled connections alive
+// indefinitely.
+func NewClientWithPool(requestSocket, eventSocket string, size int, idle time.Duration) (*IPCClient, error) {
+	c, err := NewClient(requestSocket, eventSocket)
+	if err != nil {
+		return nil, err
+	}
+	c.Pool = newPool(c.requestConn, size, idle)
+	return c, nil
+}
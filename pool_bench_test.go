@@ -0,0 +1,79 @@
+package hyprland
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// startEchoServer starts a unix socket listener that answers every request
+// with "ok", just enough of Hyprland's request socket behaviour to
+// benchmark the dial-per-request path against the pooled one.
+func startEchoServer(tb testing.TB) (socket string, stop func()) {
+	tb.Helper()
+
+	socket = filepath.Join(tb.TempDir(), ".s")
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		tb.Fatalf("error while listening: %v", err)
+	}
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				buf := make([]byte, BUF_SIZE)
+				for {
+					if _, err := conn.Read(buf); err != nil {
+						return
+					}
+					if _, err := conn.Write([]byte("ok")); err != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+
+	return socket, func() { l.Close() }
+}
+
+func BenchmarkRequest(b *testing.B) {
+	socket, stop := startEchoServer(b)
+	defer stop()
+
+	c, err := NewClient(socket, socket)
+	if err != nil {
+		b.Fatalf("error while creating client: %v", err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.Request([]byte("version")); err != nil {
+			b.Fatalf("error while requesting: %v", err)
+		}
+	}
+}
+
+func BenchmarkRequestPooled(b *testing.B) {
+	socket, stop := startEchoServer(b)
+	defer stop()
+
+	c, err := NewClientWithPool(socket, socket, 4, time.Minute)
+	if err != nil {
+		b.Fatalf("error while creating client: %v", err)
+	}
+	defer c.Pool.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.Request([]byte("version")); err != nil {
+			b.Fatalf("error while requesting: %v", err)
+		}
+	}
+}
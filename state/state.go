@@ -0,0 +1,289 @@
+// Package state maintains a live, thread-safe view of monitors, workspaces
+// and clients, so that status bars and panels don't have to race raw IPC
+// calls against the event socket themselves.
+package state
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/thiagokokada/hyprland-go"
+)
+
+// Snapshot is an immutable view of everything [Store] tracks, handed to
+// subscribers whenever the store changes.
+type Snapshot struct {
+	Monitors   []hyprland.Monitor
+	Workspaces []hyprland.Workspace
+	Clients    []hyprland.Client
+}
+
+// Store is a reactive, thread-safe cache of monitors, workspaces and
+// clients. It is seeded from the request socket and kept up to date by
+// applying deltas read from the event socket via [Store.Run].
+type Store struct {
+	mu         sync.RWMutex
+	monitors   []hyprland.Monitor
+	workspaces []hyprland.Workspace
+	clients    []hyprland.Client
+
+	subsMu sync.Mutex
+	subs   []func(Snapshot)
+}
+
+// New creates a Store seeded from ipc. Call [Store.Run] to start applying
+// event deltas on top of the seeded state.
+func New(ipc *hyprland.IPCClient) (*Store, error) {
+	s := &Store{}
+	if err := s.seed(ipc); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Store) seed(ipc *hyprland.IPCClient) error {
+	monitors, err := ipc.Monitors()
+	if err != nil {
+		return fmt.Errorf("error while seeding monitors: %w", err)
+	}
+	workspaces, err := ipc.Workspaces()
+	if err != nil {
+		return fmt.Errorf("error while seeding workspaces: %w", err)
+	}
+	clients, err := ipc.Clients()
+	if err != nil {
+		return fmt.Errorf("error while seeding clients: %w", err)
+	}
+
+	s.mu.Lock()
+	s.monitors, s.workspaces, s.clients = monitors, workspaces, clients
+	s.mu.Unlock()
+	return nil
+}
+
+// Run reads events from ec and applies them to the store until ctx is
+// cancelled or the event connection is closed, notifying subscribers on
+// every change. It blocks, so callers typically run it in its own
+// goroutine.
+func (s *Store) Run(ctx context.Context, ec *hyprland.EventClient) error {
+	return ec.Subscribe(ctx, &storeHandler{store: s})
+}
+
+// Monitors returns the current monitors.
+func (s *Store) Monitors() []hyprland.Monitor {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]hyprland.Monitor(nil), s.monitors...)
+}
+
+// WorkspacesByMonitor returns the workspaces currently living on monitor.
+func (s *Store) WorkspacesByMonitor(monitor int) []hyprland.Workspace {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var out []hyprland.Workspace
+	for _, w := range s.workspaces {
+		if w.MonitorID == monitor {
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
+// ClientsByWorkspace returns the clients currently living on workspace.
+func (s *Store) ClientsByWorkspace(workspace int) []hyprland.Client {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var out []hyprland.Client
+	for _, c := range s.clients {
+		if c.Workspace.Id == workspace {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// ActiveWorkspace returns the workspace currently active on monitor.
+func (s *Store) ActiveWorkspace(monitor int) hyprland.Workspace {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, m := range s.monitors {
+		if m.Id != monitor {
+			continue
+		}
+		for _, w := range s.workspaces {
+			if w.Id == m.ActiveWorkspace.Id {
+				return w
+			}
+		}
+	}
+	return hyprland.Workspace{}
+}
+
+// Snapshot returns a copy of everything the store currently tracks.
+func (s *Store) Snapshot() Snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Snapshot{
+		Monitors:   append([]hyprland.Monitor(nil), s.monitors...),
+		Workspaces: append([]hyprland.Workspace(nil), s.workspaces...),
+		Clients:    append([]hyprland.Client(nil), s.clients...),
+	}
+}
+
+// Subscribe registers fn to be called with a [Snapshot] whenever the store
+// changes. The returned func removes the subscription.
+func (s *Store) Subscribe(fn func(Snapshot)) (unsubscribe func()) {
+	s.subsMu.Lock()
+	defer s.subsMu.Unlock()
+
+	id := len(s.subs)
+	s.subs = append(s.subs, fn)
+	return func() {
+		s.subsMu.Lock()
+		defer s.subsMu.Unlock()
+		s.subs[id] = nil
+	}
+}
+
+func (s *Store) notify() {
+	snap := s.Snapshot()
+
+	s.subsMu.Lock()
+	subs := append([]func(Snapshot){}, s.subs...)
+	s.subsMu.Unlock()
+
+	for _, fn := range subs {
+		if fn != nil {
+			fn(snap)
+		}
+	}
+}
+
+// storeHandler applies event deltas to a Store. It embeds
+// [hyprland.BaseEventHandler] and only overrides the window, workspace and
+// focused-monitor events. Monitor hotplug events (monitoradded,
+// monitorremoved) are not applied, so the set of monitors stays the one the
+// store was seeded with.
+type storeHandler struct {
+	hyprland.BaseEventHandler
+	store *Store
+}
+
+// workspaceTypeByName resolves the id/name pair for the workspace called
+// name from s.workspaces, so events (which only carry a name) don't leave
+// the id-keyed lookups (ActiveWorkspace, ClientsByWorkspace) permanently
+// stale. Hyprland creates workspaces on demand, so if name isn't tracked
+// yet (e.g. a window event raced a createworkspace that never arrived, or
+// arrived for a different handler run), it is added on the spot instead of
+// handing back a zero-value placeholder. Must be called with s.mu held.
+func (s *Store) workspaceTypeByName(name string) hyprland.WorkspaceType {
+	for _, w := range s.workspaces {
+		if w.Name == name {
+			return w.WorkspaceType
+		}
+	}
+
+	ws := hyprland.WorkspaceType{Id: workspaceIdFromName(name), Name: name}
+	s.workspaces = append(s.workspaces, hyprland.Workspace{WorkspaceType: ws})
+	return ws
+}
+
+// workspaceIdFromName derives the id Hyprland would assign a workspace
+// created on demand: normal workspaces are named after their id, so it's
+// parsed back out of the name. Special/named workspaces have no numeric id
+// and fall back to 0, matching Hyprland's own placeholder for those.
+func workspaceIdFromName(name string) int {
+	id, err := strconv.Atoi(name)
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+func (h *storeHandler) OpenWindow(e hyprland.OpenWindowEvent) {
+	h.store.mu.Lock()
+	h.store.clients = append(h.store.clients, hyprland.Client{
+		Address:   e.Address,
+		Class:     e.Class,
+		Title:     e.Title,
+		Workspace: h.store.workspaceTypeByName(e.Workspace),
+	})
+	h.store.mu.Unlock()
+	h.store.notify()
+}
+
+func (h *storeHandler) CloseWindow(e hyprland.CloseWindowEvent) {
+	h.store.mu.Lock()
+	for i, c := range h.store.clients {
+		if c.Address == e.Address {
+			h.store.clients = append(h.store.clients[:i], h.store.clients[i+1:]...)
+			break
+		}
+	}
+	h.store.mu.Unlock()
+	h.store.notify()
+}
+
+func (h *storeHandler) MoveWindow(e hyprland.MoveWindowEvent) {
+	h.store.mu.Lock()
+	ws := h.store.workspaceTypeByName(e.Workspace)
+	for i, c := range h.store.clients {
+		if c.Address == e.Address {
+			h.store.clients[i].Workspace = ws
+			break
+		}
+	}
+	h.store.mu.Unlock()
+	h.store.notify()
+}
+
+func (h *storeHandler) Workspace(e hyprland.WorkspaceEvent) {
+	h.store.mu.Lock()
+	ws := h.store.workspaceTypeByName(e.Name)
+	for i := range h.store.monitors {
+		if h.store.monitors[i].Focused {
+			h.store.monitors[i].ActiveWorkspace = ws
+			break
+		}
+	}
+	h.store.mu.Unlock()
+	h.store.notify()
+}
+
+func (h *storeHandler) FocusedMon(e hyprland.FocusedMonEvent) {
+	h.store.mu.Lock()
+	ws := h.store.workspaceTypeByName(e.WorkspaceName)
+	for i := range h.store.monitors {
+		focused := h.store.monitors[i].Name == e.MonitorName
+		h.store.monitors[i].Focused = focused
+		if focused {
+			h.store.monitors[i].ActiveWorkspace = ws
+		}
+	}
+	h.store.mu.Unlock()
+	h.store.notify()
+}
+
+func (h *storeHandler) CreateWorkspace(e hyprland.CreateWorkspaceEvent) {
+	h.store.mu.Lock()
+	h.store.workspaceTypeByName(e.Name)
+	h.store.mu.Unlock()
+	h.store.notify()
+}
+
+func (h *storeHandler) DestroyWorkspace(e hyprland.DestroyWorkspaceEvent) {
+	h.store.mu.Lock()
+	for i, w := range h.store.workspaces {
+		if w.Name == e.Name {
+			h.store.workspaces = append(h.store.workspaces[:i], h.store.workspaces[i+1:]...)
+			break
+		}
+	}
+	h.store.mu.Unlock()
+	h.store.notify()
+}
@@ -1,6 +1,11 @@
 package hyprland
 
-import "net"
+import (
+	"encoding/json"
+	"net"
+	"strconv"
+	"strings"
+)
 
 type RawRequest []byte
 
@@ -10,11 +15,133 @@ type RawData string
 
 type EventType string
 
+// Event names as they appear on the wire, before the `>>` separator.
+// https://wiki.hyprland.org/IPC/
+const (
+	EventWorkspace        EventType = "workspace"
+	EventFocusedMon       EventType = "focusedmon"
+	EventActiveWindow     EventType = "activewindow"
+	EventOpenWindow       EventType = "openwindow"
+	EventCloseWindow      EventType = "closewindow"
+	EventMoveWindow       EventType = "movewindow"
+	EventFullscreen       EventType = "fullscreen"
+	EventMonitorAdded     EventType = "monitoradded"
+	EventMonitorRemoved   EventType = "monitorremoved"
+	EventCreateWorkspace  EventType = "createworkspace"
+	EventDestroyWorkspace EventType = "destroyworkspace"
+	EventSubmap           EventType = "submap"
+)
+
 type ReceivedData struct {
 	Type EventType
 	Data RawData
 }
 
+// WorkspaceEvent is sent when the active workspace changes.
+type WorkspaceEvent struct {
+	Name string
+}
+
+// FocusedMonEvent is sent when the active monitor changes.
+type FocusedMonEvent struct {
+	MonitorName   string
+	WorkspaceName string
+}
+
+// ActiveWindowEvent is sent when the active window changes.
+type ActiveWindowEvent struct {
+	Class string
+	Title string
+}
+
+// OpenWindowEvent is sent when a new window is opened.
+type OpenWindowEvent struct {
+	Address   string
+	Workspace string
+	Class     string
+	Title     string
+}
+
+// CloseWindowEvent is sent when a window is closed.
+type CloseWindowEvent struct {
+	Address string
+}
+
+// MoveWindowEvent is sent when a window is moved to a different workspace.
+type MoveWindowEvent struct {
+	Address   string
+	Workspace string
+}
+
+// FullscreenEvent is sent when a window enters or leaves fullscreen.
+type FullscreenEvent struct {
+	Enabled bool
+}
+
+// MonitorAddedEvent is sent when a monitor is plugged in.
+type MonitorAddedEvent struct {
+	Name string
+}
+
+// MonitorRemovedEvent is sent when a monitor is unplugged.
+type MonitorRemovedEvent struct {
+	Name string
+}
+
+// CreateWorkspaceEvent is sent when a workspace is created.
+type CreateWorkspaceEvent struct {
+	Name string
+}
+
+// DestroyWorkspaceEvent is sent when a workspace is destroyed.
+type DestroyWorkspaceEvent struct {
+	Name string
+}
+
+// SubmapEvent is sent when the active submap changes.
+type SubmapEvent struct {
+	Name string
+}
+
+// EventHandler reacts to events read from the event socket. Embed
+// [BaseEventHandler] to only override the events you care about.
+type EventHandler interface {
+	Workspace(WorkspaceEvent)
+	FocusedMon(FocusedMonEvent)
+	ActiveWindow(ActiveWindowEvent)
+	OpenWindow(OpenWindowEvent)
+	CloseWindow(CloseWindowEvent)
+	MoveWindow(MoveWindowEvent)
+	Fullscreen(FullscreenEvent)
+	MonitorAdded(MonitorAddedEvent)
+	MonitorRemoved(MonitorRemovedEvent)
+	CreateWorkspace(CreateWorkspaceEvent)
+	DestroyWorkspace(DestroyWorkspaceEvent)
+	Submap(SubmapEvent)
+	// OnRaw is called for events that don't have a typed method above,
+	// e.g. new events added by Hyprland that this library doesn't know
+	// about yet.
+	OnRaw(ReceivedData)
+}
+
+// BaseEventHandler is a no-op [EventHandler]. Embed it in your own type so
+// you only need to implement the events you actually care about.
+type BaseEventHandler struct{}
+
+func (BaseEventHandler) Workspace(WorkspaceEvent)               {}
+func (BaseEventHandler) FocusedMon(FocusedMonEvent)             {}
+func (BaseEventHandler) ActiveWindow(ActiveWindowEvent)         {}
+func (BaseEventHandler) OpenWindow(OpenWindowEvent)             {}
+func (BaseEventHandler) CloseWindow(CloseWindowEvent)           {}
+func (BaseEventHandler) MoveWindow(MoveWindowEvent)             {}
+func (BaseEventHandler) Fullscreen(FullscreenEvent)             {}
+func (BaseEventHandler) MonitorAdded(MonitorAddedEvent)         {}
+func (BaseEventHandler) MonitorRemoved(MonitorRemovedEvent)     {}
+func (BaseEventHandler) CreateWorkspace(CreateWorkspaceEvent)   {}
+func (BaseEventHandler) DestroyWorkspace(DestroyWorkspaceEvent) {}
+func (BaseEventHandler) Submap(SubmapEvent)                     {}
+func (BaseEventHandler) OnRaw(ReceivedData)                     {}
+
 // RequestClient is the main struct from hyprland-go.
 // You may want to set 'Validate' as false to avoid (possibly costly)
 // validations, at the expense of not reporting some errors in the IPC.
@@ -92,6 +219,87 @@ type Option struct {
 	Set    bool   `json:"set"`
 }
 
+// OptionKind identifies which field of [TypedOption] carries a 'getoption'
+// response's value.
+type OptionKind int
+
+const (
+	OptionKindInt OptionKind = iota
+	OptionKindFloat
+	OptionKindString
+	OptionKindVec
+)
+
+// TypedOption is the response of 'hyprctl getoption', which can hold an
+// int, a float, a string or a vector (e.g. a gaps or color value)
+// depending on the option. Kind reports which field is populated.
+type TypedOption struct {
+	Kind  OptionKind
+	Int   int64
+	Float float64
+	Str   string
+	Vec   []float64
+	Set   bool
+}
+
+// FloatOptions lists options whose legitimate range includes Hyprland's
+// "unset" sentinel (-1), so "int" and "float" both reading -1 can't be told
+// apart from the response alone. This can't be derived from the getoption
+// response itself, so it has to be kept in sync by hand against Hyprland's
+// variable list (https://wiki.hyprland.org/Configuring/Variables/) whenever
+// a new float option with a [-1, ...] range shows up. It's a var, not a
+// const map, so callers hitting a missing option can add to it themselves.
+var FloatOptions = map[string]bool{
+	"input:sensitivity":            true,
+	"input:touchpad:scroll_factor": true,
+}
+
+func (o *TypedOption) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Option string  `json:"option"`
+		Int    int64   `json:"int"`
+		Float  float64 `json:"float"`
+		Str    string  `json:"str"`
+		Set    bool    `json:"set"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	o.Set = raw.Set
+	switch {
+	case raw.Str != "":
+		if vec, ok := parseOptionVec(raw.Str); ok {
+			o.Kind, o.Vec = OptionKindVec, vec
+		} else {
+			o.Kind, o.Str = OptionKindString, raw.Str
+		}
+	case raw.Float != -1 || FloatOptions[raw.Option]:
+		o.Kind, o.Float = OptionKindFloat, raw.Float
+	default:
+		o.Kind, o.Int = OptionKindInt, raw.Int
+	}
+	return nil
+}
+
+// parseOptionVec parses a whitespace-separated list of numbers, the form
+// Hyprland uses for multi-value options such as gaps, into a vector.
+func parseOptionVec(s string) (vec []float64, ok bool) {
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return nil, false
+	}
+	vec = make([]float64, 0, len(fields))
+	for _, f := range fields {
+		v, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return nil, false
+		}
+		vec = append(vec, v)
+	}
+	return vec, true
+}
+
 type Version struct {
 	Branch        string   `json:"branch"`
 	Commit        string   `json:"commit"`
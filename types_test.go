@@ -0,0 +1,56 @@
+package hyprland
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestTypedOptionUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want TypedOption
+	}{
+		{
+			name: "int",
+			json: `{"option":"general:gaps_in","int":5,"float":-1,"str":"","set":true}`,
+			want: TypedOption{Kind: OptionKindInt, Int: 5, Set: true},
+		},
+		{
+			name: "float",
+			json: `{"option":"general:sensitivity","int":0,"float":0.5,"str":"","set":true}`,
+			want: TypedOption{Kind: OptionKindFloat, Float: 0.5, Set: true},
+		},
+		{
+			name: "string",
+			json: `{"option":"general:layout","int":0,"float":-1,"str":"dwindle","set":true}`,
+			want: TypedOption{Kind: OptionKindString, Str: "dwindle", Set: true},
+		},
+		{
+			name: "vec",
+			json: `{"option":"general:gaps_out","int":0,"float":-1,"str":"5 10 5 10","set":true}`,
+			want: TypedOption{Kind: OptionKindVec, Vec: []float64{5, 10, 5, 10}, Set: true},
+		},
+		{
+			// Regression test for the heuristic's blind spot: a float
+			// option whose value is legitimately pinned at -1 (e.g.
+			// input:sensitivity) must not be misread as an int.
+			name: "float pinned at -1 via FloatOptions allowlist",
+			json: `{"option":"input:sensitivity","int":-1,"float":-1,"str":"","set":true}`,
+			want: TypedOption{Kind: OptionKindFloat, Float: -1, Set: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got TypedOption
+			if err := json.Unmarshal([]byte(tt.json), &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}